@@ -0,0 +1,122 @@
+// Package adapters provides StartFn/ShutdownFn pairs for common server types, so that
+// adding them to a gracegroup.Group doesn't require hand-rolling the translation of
+// their expected-on-shutdown errors (e.g. http.ErrServerClosed) into nil.
+package adapters
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/dro-sh/gracegroup"
+)
+
+// HTTPServer adapts an *http.Server to a StartFn/ShutdownFn pair. srv.ListenAndServe
+// always returns a non-nil error, http.ErrServerClosed once srv.Shutdown is called;
+// HTTPServer translates that into nil so it does not itself trigger group shutdown.
+func HTTPServer(srv *http.Server) (gracegroup.StartFn, gracegroup.ShutdownFn) {
+	start := func() error {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	}
+
+	shutdown := func(ctx context.Context) error {
+		return srv.Shutdown(ctx)
+	}
+
+	return start, shutdown
+}
+
+// GRPCServer adapts a *grpc.Server serving on lis to a StartFn/ShutdownFn pair.
+// srv.Serve always returns a non-nil error, grpc.ErrServerStopped once srv.Stop or
+// srv.GracefulStop is called; GRPCServer translates that into nil so it does not
+// itself trigger group shutdown. ShutdownFn calls srv.GracefulStop, falling back to
+// the immediate srv.Stop if ctx is done first.
+func GRPCServer(srv *grpc.Server, lis net.Listener) (gracegroup.StartFn, gracegroup.ShutdownFn) {
+	start := func() error {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			return err
+		}
+
+		return nil
+	}
+
+	shutdown := func(ctx context.Context) error {
+		stopped := make(chan struct{})
+
+		go func() {
+			srv.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			srv.Stop()
+
+			return ctx.Err()
+		}
+	}
+
+	return start, shutdown
+}
+
+// Listener adapts a net.Listener and the function that serves it, e.g.
+// (*http.Server).Serve or a hand-rolled accept loop, to a StartFn/ShutdownFn pair.
+// serve is expected to return net.ErrClosed once ShutdownFn closes lis; Listener
+// translates that into nil so it does not itself trigger group shutdown.
+func Listener(lis net.Listener, serve func(net.Listener) error) (gracegroup.StartFn, gracegroup.ShutdownFn) {
+	start := func() error {
+		if err := serve(lis); err != nil && !errors.Is(err, net.ErrClosed) {
+			return err
+		}
+
+		return nil
+	}
+
+	shutdown := func(context.Context) error {
+		return lis.Close()
+	}
+
+	return start, shutdown
+}
+
+// Worker adapts a long-running, context-aware function to a StartFn/ShutdownFn pair:
+// fn runs until ShutdownFn cancels its context, so fn returning ctx.Err() at that
+// point is expected and does not itself trigger group shutdown. ShutdownFn blocks
+// until fn has actually returned, so the group doesn't advance to Cleanup while fn is
+// still draining, falling back to the shutdown ctx's error if fn doesn't return in time.
+func Worker(fn func(ctx context.Context) error) (gracegroup.StartFn, gracegroup.ShutdownFn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	start := func() error {
+		defer close(done)
+
+		if err := fn(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		return nil
+	}
+
+	shutdown := func(shutdownCtx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-shutdownCtx.Done():
+			return shutdownCtx.Err()
+		}
+	}
+
+	return start, shutdown
+}
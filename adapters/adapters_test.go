@@ -0,0 +1,176 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/dro-sh/gracegroup/adapters"
+)
+
+func TestHTTPServerTranslatesErrServerClosed(t *testing.T) {
+	t.Parallel()
+
+	srv := &http.Server{Addr: "127.0.0.1:0"}
+
+	start, shutdown := adapters.HTTPServer(srv)
+
+	startErr := make(chan error, 1)
+
+	go func() { startErr <- start() }()
+
+	// give ListenAndServe a moment to bind before asking it to stop.
+	time.Sleep(50 * time.Millisecond)
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no error from shutdown, got %v", err)
+	}
+
+	if err := <-startErr; err != nil {
+		t.Errorf("expected http.ErrServerClosed to be translated to nil, got %v", err)
+	}
+}
+
+func TestGRPCServerTranslatesErrServerStopped(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	srv := grpc.NewServer()
+
+	start, shutdown := adapters.GRPCServer(srv, lis)
+
+	startErr := make(chan error, 1)
+
+	go func() { startErr <- start() }()
+
+	time.Sleep(50 * time.Millisecond)
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no error from shutdown, got %v", err)
+	}
+
+	if err := <-startErr; err != nil {
+		t.Errorf("expected grpc.ErrServerStopped to be translated to nil, got %v", err)
+	}
+}
+
+func TestListenerTranslatesErrClosed(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	start, shutdown := adapters.Listener(lis, func(l net.Listener) error {
+		for {
+			if _, err := l.Accept(); err != nil {
+				return err
+			}
+		}
+	})
+
+	startErr := make(chan error, 1)
+
+	go func() { startErr <- start() }()
+
+	time.Sleep(50 * time.Millisecond)
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no error from shutdown, got %v", err)
+	}
+
+	if err := <-startErr; err != nil {
+		t.Errorf("expected net.ErrClosed to be translated to nil, got %v", err)
+	}
+}
+
+func TestWorkerStopsOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	errWorker := errors.New("worker error")
+
+	returnedErr := errWorker
+
+	start, shutdown := adapters.Worker(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return returnedErr
+	})
+
+	startErr := make(chan error, 1)
+
+	go func() { startErr <- start() }()
+
+	returnedErr = context.Canceled
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no error from shutdown, got %v", err)
+	}
+
+	if err := <-startErr; err != nil {
+		t.Errorf("expected context.Canceled to be translated to nil, got %v", err)
+	}
+}
+
+func TestWorkerShutdownWaitsForFnToReturn(t *testing.T) {
+	t.Parallel()
+
+	fnReturned := make(chan struct{})
+
+	start, shutdown := adapters.Worker(func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		close(fnReturned)
+
+		return nil
+	})
+
+	go func() { _ = start() }()
+
+	time.Sleep(10 * time.Millisecond)
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no error from shutdown, got %v", err)
+	}
+
+	select {
+	case <-fnReturned:
+	default:
+		t.Error("expected shutdown to block until fn returned")
+	}
+}
+
+func TestWorkerShutdownReturnsCtxErrIfFnDoesNotReturnInTime(t *testing.T) {
+	t.Parallel()
+
+	blockForever := make(chan struct{})
+
+	start, shutdown := adapters.Worker(func(ctx context.Context) error {
+		<-blockForever
+
+		return nil
+	})
+
+	go func() { _ = start() }()
+
+	time.Sleep(10 * time.Millisecond)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := shutdown(shutdownCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	close(blockForever)
+}
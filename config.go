@@ -1,15 +1,40 @@
 package gracegroup
 
-import "time"
+import (
+	"context"
+	"os"
+	"time"
+)
 
-var DefaultShutdownTimeout = 5 * time.Second
+var (
+	DefaultShutdownTimeout = 5 * time.Second
+	DefaultCleanupTimeout  = 5 * time.Second
+)
 
 type Config struct {
 	// ShutdownTimeout is the maximum amount of time to wait for execution of all shutdown functions.
 	// After this period, the shutdown process wont be waiting to finish.
 	ShutdownTimeout time.Duration
+
+	// CleanupTimeout is the maximum amount of time to wait for execution of all Cleanup functions,
+	// which run after every shutdown function has finished. After this period, the cleanup process
+	// wont be waiting to finish.
+	CleanupTimeout time.Duration
+
+	// IgnoreErrors lists errors that must not be treated as failures when returned by a start
+	// or shutdown function. Errors are matched with errors.Is, so sentinel errors such as
+	// http.ErrServerClosed or grpc.ErrServerStopped can be listed directly. A matched error is
+	// suppressed: it neither triggers group shutdown nor is returned from Wait.
+	IgnoreErrors []error
+
+	// SignalHandler, if set, is used by Runner.Run instead of signal.Notify to obtain the
+	// channel of incoming OS signals. It exists so tests can inject fake signals without
+	// sending real ones to the test process.
+	SignalHandler func(ctx context.Context) <-chan os.Signal
 }
 
 var DefaultConfig = Config{
 	ShutdownTimeout: DefaultShutdownTimeout,
+	CleanupTimeout:  DefaultCleanupTimeout,
+	IgnoreErrors:    []error{context.Canceled, context.DeadlineExceeded},
 }
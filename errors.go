@@ -0,0 +1,46 @@
+package gracegroup
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrStartFuncFailed is the cause Wait records, and tags onto every ShutdownFn's
+// context, when a start function returns a non-ignored error. Name is the failing
+// service's WithName, if any.
+type ErrStartFuncFailed struct {
+	Name string
+	Err  error
+}
+
+func (e *ErrStartFuncFailed) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("start %q failed: %v", e.Name, e.Err)
+	}
+
+	return fmt.Sprintf("start failed: %v", e.Err)
+}
+
+func (e *ErrStartFuncFailed) Unwrap() error {
+	return e.Err
+}
+
+// ErrParentContextCanceled is the cause Wait records, and tags onto every ShutdownFn's
+// context, when the context passed to Wait is done before any start function fails.
+// Err is context.Cause of that context, so e.g. errors.Is(err, context.Canceled) still
+// distinguishes a plain cancellation (such as a caught SIGTERM) from a deadline.
+type ErrParentContextCanceled struct {
+	Err error
+}
+
+func (e *ErrParentContextCanceled) Error() string {
+	return fmt.Sprintf("parent context done: %v", e.Err)
+}
+
+func (e *ErrParentContextCanceled) Unwrap() error {
+	return e.Err
+}
+
+// ErrShutdownTimeout is the cause attached to every ShutdownFn's context once
+// Config.ShutdownTimeout elapses before all of them have finished.
+var ErrShutdownTimeout = fmt.Errorf("gracegroup: shutdown timeout exceeded: %w", context.DeadlineExceeded)
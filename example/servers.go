@@ -1,20 +1,17 @@
 package main
 
 import (
-	"context"
+	"errors"
 	"log"
 	"net/http"
-	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/dro-sh/gracegroup"
+	"github.com/dro-sh/gracegroup/adapters"
 )
 
 func main() {
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
-	defer stop()
-
 	// some initialization code with db, logger, etc
 	// that has defer functions to close connections, flush buffers, etc
 
@@ -30,11 +27,13 @@ func main() {
 		ReadHeaderTimeout: 1 * time.Second,
 	}
 
-	group := gracegroup.New(gracegroup.DefaultConfig)
+	runner := gracegroup.NewRunner(gracegroup.DefaultConfig)
+
+	runner.Add(adapters.HTTPServer(&srv))
 
-	group.Add(srv.ListenAndServe, srv.Shutdown)
+	var sigErr *gracegroup.SignalError
 
-	if err := group.Wait(ctx); err != nil {
+	if err := runner.Run(syscall.SIGTERM, syscall.SIGINT); err != nil && !errors.As(err, &sigErr) {
 		panic(err) // dont fatal because upper could be defer functions
 	}
 
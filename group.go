@@ -3,6 +3,7 @@ package gracegroup
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"golang.org/x/sync/errgroup"
@@ -19,31 +20,139 @@ type (
 	ShutdownFn func(ctx context.Context) error
 )
 
+// AddOption customizes how a single service added via AddWithOptions behaves.
+type AddOption func(*addOptions)
+
+type addOptions struct {
+	name         string
+	ignoreErrors []error
+	deps         []string
+}
+
+// WithName assigns a name to a service. The name is used to wrap any non-ignored error
+// returned by its start or shutdown function, e.g. `fmt.Errorf("%s: %w", name, err)`.
+func WithName(name string) AddOption {
+	return func(o *addOptions) {
+		o.name = name
+	}
+}
+
+// WithIgnoredErrors adds errors, matched via errors.Is, that must not be treated as
+// failures when returned by this service's start or shutdown function. These are
+// checked in addition to Config.IgnoreErrors.
+func WithIgnoredErrors(errs ...error) AddOption {
+	return func(o *addOptions) {
+		o.ignoreErrors = append(o.ignoreErrors, errs...)
+	}
+}
+
+// withDeps records the names of services this service depends on, for use by AddNamed.
+func withDeps(deps ...string) AddOption {
+	return func(o *addOptions) {
+		o.deps = append(o.deps, deps...)
+	}
+}
+
+type service struct {
+	start    StartFn
+	shutdown ShutdownFn
+	opts     addOptions
+}
+
+// causeKey is the context key ShutdownFn's context carries its triggering cause under.
+type causeKey struct{}
+
+// Cause returns the reason the group began shutting down, as tagged on the ctx
+// argument of a ShutdownFn. It is one of *ErrStartFuncFailed, *ErrParentContextCanceled,
+// or nil if Wait was given no reason to shut down (every start function returned nil).
+// It mirrors Group.ShutdownCause, but is reachable without a reference to the Group.
+func Cause(ctx context.Context) error {
+	cause, _ := ctx.Value(causeKey{}).(error)
+
+	return cause
+}
+
 // Gracegroup is a managare to execute processes and functions to shutdown processes.
 type Group struct {
 	mu sync.Mutex
 
-	cfg         Config
-	startFns    []StartFn
-	shutdownFns []ShutdownFn
+	cfg           Config
+	services      []service
+	goFns         []func(ctx context.Context) error
+	cleanupFns    []func(ctx context.Context) error
+	shutdownCause error
+	forceCh       chan struct{}
+	forceOnce     sync.Once
 }
 
 func New(cfg Config) *Group {
 	return &Group{
-		cfg:         cfg,
-		startFns:    make([]StartFn, 0),
-		shutdownFns: make([]ShutdownFn, 0),
+		cfg:      cfg,
+		services: make([]service, 0),
+		forceCh:  make(chan struct{}),
 	}
 }
 
+// Force cancels the in-flight call to shutdown functions immediately, ignoring the
+// remainder of Config.ShutdownTimeout. It is a no-op if shutdown has not started yet
+// or has already finished. Runner uses this to implement its double-signal escape
+// hatch; most callers won't need it directly.
+func (r *Group) Force() {
+	r.forceOnce.Do(func() {
+		close(r.forceCh)
+	})
+}
+
 // Add adds a start function and a shutdown function to the group.
 // Func does not invoke start func immediately, it will wait for Wait method.
 func (r *Group) Add(start StartFn, shutdown ShutdownFn) {
+	r.AddWithOptions(start, shutdown)
+}
+
+// AddWithOptions behaves like Add but allows per-service overrides, such as WithName
+// or WithIgnoredErrors, for services that need their own error handling.
+func (r *Group) AddWithOptions(start StartFn, shutdown ShutdownFn, opts ...AddOption) {
+	var o addOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.startFns = append(r.startFns, start)
-	r.shutdownFns = append(r.shutdownFns, shutdown)
+	r.services = append(r.services, service{start: start, shutdown: shutdown, opts: o})
+}
+
+// AddNamed behaves like Add but names the service and declares the names of other
+// named services it depends on. Dependencies shut down only after everything that
+// depends on them has already shut down, see Group.shutdown for the ordering.
+func (r *Group) AddNamed(name string, start StartFn, shutdown ShutdownFn, deps ...string) {
+	r.AddWithOptions(start, shutdown, WithName(name), withDeps(deps...))
+}
+
+// Go runs fn in its own goroutine for the lifetime of the group. Unlike a StartFn, fn
+// returning, with or without an error, never triggers group shutdown; instead fn is
+// given a context that is canceled once Wait starts shutting down, and Wait does not
+// return until fn does. Use Go for background work that should simply stop alongside
+// the group, e.g. a metrics poller, as opposed to a service whose failure matters.
+func (r *Group) Go(fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.goFns = append(r.goFns, fn)
+}
+
+// Cleanup registers fn to run once every shutdown function has finished, under
+// CleanupTimeout rather than ShutdownTimeout. Cleanup functions run in LIFO order,
+// one at a time, mirroring the usual defer convention. Use Cleanup for work that must
+// happen strictly after services have drained, e.g. flushing a tracer or metrics
+// exporter only once the HTTP server it instruments has stopped accepting requests.
+func (r *Group) Cleanup(fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cleanupFns = append(r.cleanupFns, fn)
 }
 
 // Wait does next things:
@@ -58,65 +167,328 @@ func (r *Group) Add(start StartFn, shutdown ShutdownFn) {
 // must support context DeadlineExceeded error and exit on it. Group does not forcelly stop shutdown
 // then context deadline exceeded.
 //
+// An error matched against Config.IgnoreErrors or a service's WithIgnoredErrors (via errors.Is)
+// is suppressed: it neither triggers group shutdown nor is returned from Wait.
+//
+// Internally, Wait propagates the reason shutdown began through a context.WithCancelCause,
+// tagged as an *ErrStartFuncFailed or *ErrParentContextCanceled. That cause is available
+// afterwards from Group.ShutdownCause, and is tagged onto every ShutdownFn's context,
+// retrievable with gracegroup.Cause, so shutdown functions can tell why they were asked
+// to stop (e.g. a fast drain on sibling failure vs. a full flush on SIGTERM).
+//
+// Goroutines started with Go are canceled and awaited around the same point start
+// functions would be, but cannot trigger or delay shutdown themselves. Functions
+// registered with Cleanup run last, once every shutdown function has returned.
+//
 // Wait could return error from:
 //  1. one of start functions,
 //  2. one of shutdown functions,
-//  3. error from Wait context if it is not context.Calceled error,
-//  4. context.DeadlineExceeded if cfg.ShutdownTimeout is exceeded on shutdown.
+//  3. one of Go or Cleanup functions,
+//  4. error from Wait context if it is not context.Calceled error,
+//  5. context.DeadlineExceeded if cfg.ShutdownTimeout is exceeded on shutdown.
 func (r *Group) Wait(ctx context.Context) error {
-	g, ctx := errgroup.WithContext(ctx)
+	runCtx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	goCtx, cancelGo := context.WithCancel(context.Background())
+	defer cancelGo()
+
+	goErrs := make([]error, len(r.goFns))
+
+	var goWg sync.WaitGroup
+
+	for i, fn := range r.goFns {
+		i, fn := i, fn
+
+		goWg.Add(1)
+
+		go func() {
+			defer goWg.Done()
+
+			goErrs[i] = fn(goCtx)
+		}()
+	}
+
+	g := &errgroup.Group{}
+
+	for _, svc := range r.services {
+		svc := svc
+
+		g.Go(func() error {
+			rawErr := svc.start()
+			err := r.wrapErr(svc, rawErr)
+
+			if err != nil {
+				cancel(&ErrStartFuncFailed{Name: svc.opts.name, Err: rawErr})
+			}
+
+			return err
+		})
+	}
 
-	for _, start := range r.startFns {
-		g.Go(start)
+	err := r.wait(ctx, runCtx, cancel, g)
+
+	cancelGo()
+	goWg.Wait()
+
+	var filteredGoErrs []error
+
+	for _, goErr := range goErrs {
+		// Go funcs commonly just return ctx.Err() once canceled; that is not a failure,
+		// regardless of whether cfg.IgnoreErrors lists context.Canceled.
+		if goErr != nil && !errors.Is(goErr, context.Canceled) && !r.ignorable(service{}, goErr) {
+			filteredGoErrs = append(filteredGoErrs, goErr)
+		}
 	}
 
-	err := r.wait(ctx, g)
+	r.mu.Lock()
+	r.shutdownCause = context.Cause(runCtx)
+	cause := r.shutdownCause
+	r.mu.Unlock()
+
+	shutdownError := r.shutdown(cause)
 
-	shutdownError := r.shutdown()
+	cleanupError := r.runCleanup(cause)
 
-	return errors.Join(shutdownError, err)
+	return errors.Join(errors.Join(filteredGoErrs...), shutdownError, cleanupError, err)
 }
 
-func (r *Group) wait(ctx context.Context, g *errgroup.Group) error {
+// ShutdownCause returns the reason the most recent call to Wait began shutting down the
+// group, see Wait for details. It is nil until Wait has started shutting down.
+func (r *Group) ShutdownCause() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.shutdownCause
+}
+
+// ignorable reports whether err matches Config.IgnoreErrors or svc's own
+// WithIgnoredErrors, via errors.Is.
+func (r *Group) ignorable(svc service, err error) bool {
+	for _, ignore := range r.cfg.IgnoreErrors {
+		if errors.Is(err, ignore) {
+			return true
+		}
+	}
+
+	for _, ignore := range svc.opts.ignoreErrors {
+		if errors.Is(err, ignore) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrapErr suppresses errors ignored for svc and, if svc was named with WithName,
+// wraps any remaining error with that name.
+func (r *Group) wrapErr(svc service, err error) error {
+	if err == nil || r.ignorable(svc, err) {
+		return nil
+	}
+
+	if svc.opts.name != "" {
+		return fmt.Errorf("%s: %w", svc.opts.name, err)
+	}
+
+	return err
+}
+
+// wrapShutdownErr is like wrapErr, but wraps a named service's error the way
+// Group.shutdown reports it, so it is recognizable as coming from shutdown.
+func (r *Group) wrapShutdownErr(svc service, err error) error {
+	if err == nil || r.ignorable(svc, err) {
+		return nil
+	}
+
+	if svc.opts.name != "" {
+		return fmt.Errorf("shutdown %q: %w", svc.opts.name, err)
+	}
+
+	return err
+}
+
+func (r *Group) wait(ctx context.Context, runCtx context.Context, cancel context.CancelCauseFunc, g *errgroup.Group) error {
 	done := make(chan struct{})
 
 	go func() {
-		//nolint:errcheck,gosec // err will be set on errgroup context cause
+		//nolint:errcheck,gosec // err will be set on runCtx cause
 		g.Wait()
 
 		close(done)
 	}()
 
-	// no needs set error from ctx or errgroup
-	// because errgroup set error cause to context on wait method
-	// or argument context has error cause
 	select {
 	case <-done:
 	case <-ctx.Done():
+		cancel(&ErrParentContextCanceled{Err: context.Cause(ctx)})
+	case <-runCtx.Done():
+		// a start function already set the cause, e.g. via ErrStartFuncFailed
 	}
 
-	if err := context.Cause(ctx); !errors.Is(err, context.Canceled) {
+	if err := context.Cause(runCtx); !errors.Is(err, context.Canceled) {
 		return err
 	}
 
 	return nil
 }
 
-func (r *Group) shutdown() error {
-	ctx, cancel := context.WithCancel(context.Background())
+// shutdown runs every service's ShutdownFn in reverse-topological waves built from the
+// dependency graph declared via AddNamed: services nothing else depends on shut down
+// first, then the services they depended on, and so on, so e.g. an HTTP server stops
+// before the DB pool it uses. Services within the same wave run concurrently. Services
+// added without AddNamed, or with deps that don't resolve to a named service, have no
+// ordering constraints and end up in the first wave.
+//
+// cause is the reason Wait began shutting down (see Wait and ShutdownCause); it is
+// tagged onto every ShutdownFn's context and retrievable with gracegroup.Cause, so a
+// ShutdownFn can distinguish why it was asked to stop. If ShutdownTimeout elapses, or
+// Force is called, before all ShutdownFns finish, that context is canceled early.
+func (r *Group) shutdown(cause error) error {
+	base := context.WithValue(context.Background(), causeKey{}, cause)
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
 
 	if r.cfg.ShutdownTimeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, r.cfg.ShutdownTimeout)
+		ctx, cancel = context.WithTimeoutCause(base, r.cfg.ShutdownTimeout, ErrShutdownTimeout)
+	} else {
+		var cancelCause context.CancelCauseFunc
+
+		ctx, cancelCause = context.WithCancelCause(base)
+		cancel = func() { cancelCause(nil) }
 	}
 	defer cancel()
 
-	g := &errgroup.Group{}
+	stopForceWatch := make(chan struct{})
+	defer close(stopForceWatch)
 
-	for _, shutdownFn := range r.shutdownFns {
-		g.Go(func() error {
-			return shutdownFn(ctx)
-		})
+	go func() {
+		select {
+		case <-r.forceCh:
+			cancel()
+		case <-stopForceWatch:
+		}
+	}()
+
+	var errs []error
+
+	for _, wave := range r.shutdownWaves() {
+		var (
+			wg       sync.WaitGroup
+			waveMu   sync.Mutex
+			waveErrs []error
+		)
+
+		for _, idx := range wave {
+			svc := r.services[idx]
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if err := r.wrapShutdownErr(svc, svc.shutdown(ctx)); err != nil {
+					waveMu.Lock()
+					waveErrs = append(waveErrs, err)
+					waveMu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		errs = append(errs, waveErrs...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// runCleanup runs every function registered with Cleanup, in LIFO order, one at a
+// time, under a context tagged with cause (see Group.shutdown) and bounded by
+// Config.CleanupTimeout.
+func (r *Group) runCleanup(cause error) error {
+	base := context.WithValue(context.Background(), causeKey{}, cause)
+
+	ctx, cancel := context.WithCancel(base)
+
+	if r.cfg.CleanupTimeout > 0 {
+		ctx, cancel = context.WithTimeout(base, r.cfg.CleanupTimeout)
+	}
+	defer cancel()
+
+	var errs []error
+
+	for i := len(r.cleanupFns) - 1; i >= 0; i-- {
+		errs = append(errs, r.cleanupFns[i](ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// shutdownWaves groups service indices into reverse-topological waves: a service is
+// placed in a wave once every service that depends on it has already been placed in
+// an earlier wave. A dependency cycle, which AddNamed cannot prevent, is broken by
+// dumping all remaining services into one final wave rather than deadlocking.
+func (r *Group) shutdownWaves() [][]int {
+	n := len(r.services)
+
+	nameToIdx := make(map[string]int, n)
+	for i, svc := range r.services {
+		if svc.opts.name != "" {
+			nameToIdx[svc.opts.name] = i
+		}
+	}
+
+	deps := make([][]int, n)
+	dependents := make([]int, n)
+
+	for i, svc := range r.services {
+		for _, dep := range svc.opts.deps {
+			depIdx, ok := nameToIdx[dep]
+			if !ok || depIdx == i {
+				continue
+			}
+
+			deps[i] = append(deps[i], depIdx)
+			dependents[depIdx]++
+		}
+	}
+
+	removed := make([]bool, n)
+
+	var waves [][]int
+
+	for remaining := n; remaining > 0; {
+		var wave []int
+
+		for i := 0; i < n; i++ {
+			if !removed[i] && dependents[i] == 0 {
+				wave = append(wave, i)
+			}
+		}
+
+		if len(wave) == 0 {
+			for i := 0; i < n; i++ {
+				if !removed[i] {
+					wave = append(wave, i)
+				}
+			}
+		}
+
+		for _, i := range wave {
+			removed[i] = true
+			remaining--
+
+			for _, depIdx := range deps[i] {
+				dependents[depIdx]--
+			}
+		}
+
+		waves = append(waves, wave)
 	}
 
-	return g.Wait()
+	return waves
 }
@@ -3,6 +3,7 @@ package gracegroup_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -140,6 +141,8 @@ func TestGroup(t *testing.T) {
 	}
 
 	for _, subtest := range subtests {
+		subtest := subtest
+
 		t.Run(subtest.name, func(t *testing.T) {
 			t.Parallel()
 
@@ -223,3 +226,255 @@ func TestOneOfShutdownFunctionsReturnsError(t *testing.T) {
 		t.Errorf("worker1 should be stopped")
 	}
 }
+
+func TestGroupJoinsAllShutdownErrorsInSameWave(t *testing.T) {
+	t.Parallel()
+
+	errShutdown1 := errors.New("shutdown error for first service")
+	errShutdown2 := errors.New("shutdown error for second service")
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	g.Add(func() error { return nil }, func(ctx context.Context) error { return errShutdown1 })
+	g.Add(func() error { return nil }, func(ctx context.Context) error { return errShutdown2 })
+
+	err := g.Wait(context.Background())
+
+	if !errors.Is(err, errShutdown1) {
+		t.Errorf("expected error to contain %v, got %v", errShutdown1, err)
+	}
+
+	if !errors.Is(err, errShutdown2) {
+		t.Errorf("expected error to contain %v, got %v", errShutdown2, err)
+	}
+}
+
+func TestGroupIgnoresConfiguredErrors(t *testing.T) {
+	t.Parallel()
+
+	errIgnored := errors.New("ignored error")
+
+	g := gracegroup.New(gracegroup.Config{
+		ShutdownTimeout: gracegroup.DefaultShutdownTimeout,
+		IgnoreErrors:    []error{errIgnored},
+	})
+
+	g.Add(
+		func() error { return errIgnored },
+		func(ctx context.Context) error { return nil },
+	)
+
+	if err := g.Wait(context.Background()); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestGroupAddWithOptions(t *testing.T) {
+	t.Parallel()
+
+	errStart := errors.New("start error")
+	errShutdownIgnored := errors.New("shutdown error to ignore")
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	g.AddWithOptions(
+		func() error { return errStart },
+		func(ctx context.Context) error { return errShutdownIgnored },
+		gracegroup.WithName("worker"),
+		gracegroup.WithIgnoredErrors(errShutdownIgnored),
+	)
+
+	err := g.Wait(context.Background())
+
+	if !errors.Is(err, errStart) {
+		t.Errorf("expected error %v, got %v", errStart, err)
+	}
+
+	if errors.Is(err, errShutdownIgnored) {
+		t.Errorf("shutdown error should have been ignored, got %v", err)
+	}
+}
+
+func TestGroupAddNamedShutdownOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+
+	record := func(name string) gracegroup.ShutdownFn {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+
+			return nil
+		}
+	}
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	// db has no deps, http depends on db: http must shut down before db.
+	g.AddNamed("db", func() error { return nil }, record("db"))
+	g.AddNamed("http", func() error { return nil }, record("http"), "db")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := g.Wait(ctx); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if len(order) != 2 || order[0] != "http" || order[1] != "db" {
+		t.Errorf("expected shutdown order [http db], got %v", order)
+	}
+}
+
+func TestGroupShutdownCause(t *testing.T) {
+	t.Parallel()
+
+	errStartFn := errors.New("start Fn error")
+
+	var causeSeenByShutdownFn error
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	g.AddWithOptions(
+		func() error { return errStartFn },
+		func(ctx context.Context) error {
+			causeSeenByShutdownFn = gracegroup.Cause(ctx)
+
+			return nil
+		},
+		gracegroup.WithName("worker"),
+	)
+
+	if err := g.Wait(context.Background()); !errors.Is(err, errStartFn) {
+		t.Errorf("expected error %v, got %v", errStartFn, err)
+	}
+
+	var startFailed *gracegroup.ErrStartFuncFailed
+
+	if !errors.As(g.ShutdownCause(), &startFailed) {
+		t.Fatalf("expected ShutdownCause to be *ErrStartFuncFailed, got %v", g.ShutdownCause())
+	}
+
+	if startFailed.Name != "worker" || !errors.Is(startFailed.Err, errStartFn) {
+		t.Errorf("unexpected ErrStartFuncFailed: %+v", startFailed)
+	}
+
+	wantMsg := `start "worker" failed: ` + errStartFn.Error()
+	if got := startFailed.Error(); got != wantMsg {
+		t.Errorf("expected ErrStartFuncFailed.Error() %q, got %q", wantMsg, got)
+	}
+
+	if !errors.As(causeSeenByShutdownFn, &startFailed) {
+		t.Errorf("expected shutdown func's context to carry the same cause, got %v", causeSeenByShutdownFn)
+	}
+}
+
+func TestGroupCleanupRunsAfterShutdownInLIFOOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	g.Add(
+		func() error { return nil },
+		func(ctx context.Context) error {
+			mu.Lock()
+			order = append(order, "shutdown")
+			mu.Unlock()
+
+			return nil
+		},
+	)
+
+	g.Cleanup(func(ctx context.Context) error {
+		mu.Lock()
+		order = append(order, "cleanup1")
+		mu.Unlock()
+
+		return nil
+	})
+
+	g.Cleanup(func(ctx context.Context) error {
+		mu.Lock()
+		order = append(order, "cleanup2")
+		mu.Unlock()
+
+		return nil
+	})
+
+	if err := g.Wait(context.Background()); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	expected := []string{"shutdown", "cleanup2", "cleanup1"}
+	if len(order) != len(expected) {
+		t.Fatalf("expected order %v, got %v", expected, order)
+	}
+
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("expected order %v, got %v", expected, order)
+		}
+	}
+}
+
+func TestGroupGoStopsOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	g := gracegroup.New(gracegroup.DefaultConfig)
+
+	g.Add(
+		func() error { return nil },
+		func(ctx context.Context) error { return nil },
+	)
+
+	stopped := make(chan struct{})
+
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		close(stopped)
+
+		return ctx.Err()
+	})
+
+	if err := g.Wait(context.Background()); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	select {
+	case <-stopped:
+	default:
+		t.Errorf("expected Go func's context to be canceled by the time Wait returns")
+	}
+}
+
+func TestGroupGoCanceledErrorIgnoredRegardlessOfConfig(t *testing.T) {
+	t.Parallel()
+
+	// cfg deliberately omits context.Canceled from IgnoreErrors.
+	g := gracegroup.New(gracegroup.Config{ShutdownTimeout: gracegroup.DefaultShutdownTimeout})
+
+	g.Add(
+		func() error { return nil },
+		func(ctx context.Context) error { return nil },
+	)
+
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	if err := g.Wait(context.Background()); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package gracegroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+)
+
+// SignalError is returned by Runner.Run when it stops because of an incoming OS signal.
+type SignalError struct {
+	Signal os.Signal
+}
+
+func (e *SignalError) Error() string {
+	return fmt.Sprintf("received signal: %s", e.Signal)
+}
+
+// Runner wraps a Group with signal handling, so callers don't have to wire
+// signal.NotifyContext themselves. Its Add-like methods return the Runner itself so
+// calls can be chained, e.g.:
+//
+//	gracegroup.NewRunner(cfg).Add(adapters.HTTPServer(srv)).Run(syscall.SIGINT, syscall.SIGTERM)
+type Runner struct {
+	group *Group
+	cfg   Config
+}
+
+// NewRunner creates a Runner around a new Group built from cfg.
+func NewRunner(cfg Config) *Runner {
+	return &Runner{group: New(cfg), cfg: cfg}
+}
+
+// Add behaves like Group.Add.
+func (r *Runner) Add(start StartFn, shutdown ShutdownFn) *Runner {
+	r.group.Add(start, shutdown)
+
+	return r
+}
+
+// AddWithOptions behaves like Group.AddWithOptions.
+func (r *Runner) AddWithOptions(start StartFn, shutdown ShutdownFn, opts ...AddOption) *Runner {
+	r.group.AddWithOptions(start, shutdown, opts...)
+
+	return r
+}
+
+// AddNamed behaves like Group.AddNamed.
+func (r *Runner) AddNamed(name string, start StartFn, shutdown ShutdownFn, deps ...string) *Runner {
+	r.group.AddNamed(name, start, shutdown, deps...)
+
+	return r
+}
+
+// Go behaves like Group.Go.
+func (r *Runner) Go(fn func(ctx context.Context) error) *Runner {
+	r.group.Go(fn)
+
+	return r
+}
+
+// Cleanup behaves like Group.Cleanup.
+func (r *Runner) Cleanup(fn func(ctx context.Context) error) *Runner {
+	r.group.Cleanup(fn)
+
+	return r
+}
+
+// Group returns the underlying Group, e.g. to call Wait directly or read ShutdownCause.
+func (r *Runner) Group() *Group {
+	return r.group
+}
+
+// Run is equivalent to calling Group.Wait with a context that is canceled on the first
+// of signals, triggering graceful shutdown the same way a canceled parent context would.
+// A second signal calls Group.Force, canceling the in-flight shutdown functions'
+// context immediately rather than waiting out the rest of ShutdownTimeout, which is the
+// usual "I said stop, now" escape hatch operators expect. Run returns a *SignalError
+// for whichever signal triggered the first shutdown, joined with any error from Wait,
+// even when shutdown was clean; callers that only care about unexpected failures should
+// filter it with errors.As before treating a non-nil Run error as fatal.
+func (r *Runner) Run(signals ...os.Signal) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCh, stopSignals := r.signals(ctx, signals...)
+	defer stopSignals()
+
+	waitDone := make(chan error, 1)
+
+	go func() {
+		waitDone <- r.group.Wait(ctx)
+	}()
+
+	var signalErr error
+
+	for {
+		select {
+		case err := <-waitDone:
+			return errors.Join(err, signalErr)
+		case sig, ok := <-sigCh:
+			if !ok {
+				sigCh = nil
+
+				continue
+			}
+
+			if signalErr == nil {
+				signalErr = &SignalError{Signal: sig}
+				cancel()
+			} else {
+				r.group.Force()
+			}
+		}
+	}
+}
+
+// signals returns the channel of incoming signals Run should watch, preferring
+// Config.SignalHandler when set, and a cleanup function to release it.
+func (r *Runner) signals(ctx context.Context, signals ...os.Signal) (<-chan os.Signal, func()) {
+	if r.cfg.SignalHandler != nil {
+		return r.cfg.SignalHandler(ctx), func() {}
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+
+	return ch, func() { signal.Stop(ch) }
+}
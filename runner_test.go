@@ -0,0 +1,94 @@
+package gracegroup_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/dro-sh/gracegroup"
+)
+
+func TestRunnerRunStopsOnFirstSignal(t *testing.T) {
+	t.Parallel()
+
+	sigCh := make(chan os.Signal, 1)
+
+	r := gracegroup.NewRunner(gracegroup.Config{
+		ShutdownTimeout: gracegroup.DefaultShutdownTimeout,
+		SignalHandler: func(ctx context.Context) <-chan os.Signal {
+			return sigCh
+		},
+	})
+
+	r.Add(
+		func() error {
+			time.Sleep(100 * time.Millisecond)
+
+			return nil
+		},
+		func(ctx context.Context) error { return nil },
+	)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		sigCh <- syscall.SIGTERM
+	}()
+
+	err := r.Run(syscall.SIGTERM)
+
+	var sigErr *gracegroup.SignalError
+	if !errors.As(err, &sigErr) || sigErr.Signal != syscall.SIGTERM {
+		t.Errorf("expected SignalError for SIGTERM, got %v", err)
+	}
+}
+
+func TestRunnerRunForcesShutdownOnSecondSignal(t *testing.T) {
+	t.Parallel()
+
+	sigCh := make(chan os.Signal, 2)
+
+	forced := make(chan struct{})
+
+	r := gracegroup.NewRunner(gracegroup.Config{
+		ShutdownTimeout: time.Second,
+		SignalHandler: func(ctx context.Context) <-chan os.Signal {
+			return sigCh
+		},
+	})
+
+	r.Add(
+		func() error {
+			time.Sleep(500 * time.Millisecond)
+
+			return nil
+		},
+		func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				close(forced)
+			case <-time.After(time.Second):
+			}
+
+			return ctx.Err()
+		},
+	)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		sigCh <- syscall.SIGTERM
+
+		time.Sleep(10 * time.Millisecond)
+		sigCh <- syscall.SIGTERM
+	}()
+
+	_ = r.Run(syscall.SIGTERM)
+
+	select {
+	case <-forced:
+	default:
+		t.Errorf("expected shutdown function's context to be canceled by the second signal")
+	}
+}